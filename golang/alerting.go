@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/host"
+)
+
+const alertRulesFile = "rules.json"
+
+// AlertRule is one threshold rule loaded from the rules config file. For is
+// stored as a Go duration string (e.g. "30s") in the config and parsed into
+// ForDuration once loaded.
+type AlertRule struct {
+	Metric      string        `json:"metric"`
+	Op          string        `json:"op"`
+	Threshold   float64       `json:"threshold"`
+	For         string        `json:"for"`
+	Severity    string        `json:"severity"`
+	Action      string        `json:"action"`
+	Target      string        `json:"target"` // webhook URL or shell command, depending on Action
+	Enabled     bool          `json:"enabled"`
+	ForDuration time.Duration `json:"-"`
+}
+
+// AlertEngine evaluates rules against each metrics sample and tracks how
+// long a rule has been continuously violated before firing.
+type AlertEngine struct {
+	mu           sync.Mutex
+	Rules        []*AlertRule
+	pendingSince map[int]time.Time
+	firing       map[int]bool
+}
+
+func loadAlertRules() *AlertEngine {
+	engine := &AlertEngine{
+		pendingSince: make(map[int]time.Time),
+		firing:       make(map[int]bool),
+	}
+
+	path, err := alertRulesPath()
+	if err != nil {
+		return engine
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return engine
+	}
+
+	json.Unmarshal(data, &engine.Rules)
+	for _, rule := range engine.Rules {
+		if d, err := time.ParseDuration(rule.For); err == nil {
+			rule.ForDuration = d
+		}
+	}
+
+	return engine
+}
+
+func (e *AlertEngine) save() error {
+	path, err := alertRulesPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(e.Rules, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func alertRulesPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, metricsHistoryDir, alertRulesFile), nil
+}
+
+// Evaluate checks every enabled rule against the sample, firing rules that
+// have been continuously violated for their configured "for" duration and
+// auto-resolving rules whose condition has cleared.
+func (e *AlertEngine) Evaluate(metrics *SystemMetrics) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := metrics.LastUpdate
+
+	for i, rule := range e.Rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		value, ok := metricValue(metrics, rule.Metric)
+		if !ok {
+			continue
+		}
+
+		if compare(value, rule.Op, rule.Threshold) {
+			since, pending := e.pendingSince[i]
+			if !pending {
+				since = now
+				e.pendingSince[i] = now
+			}
+
+			if !e.firing[i] && now.Sub(since) >= rule.ForDuration {
+				e.firing[i] = true
+				fireAlert(rule, value, now)
+			}
+		} else {
+			if e.firing[i] {
+				resolveAlert(rule, value, now)
+			}
+			delete(e.pendingSince, i)
+			e.firing[i] = false
+		}
+	}
+}
+
+func metricValue(metrics *SystemMetrics, name string) (float64, bool) {
+	switch name {
+	case "cpu_usage":
+		return metrics.CPUUsage, true
+	case "mem_usage":
+		return metrics.Memory.UsedPercent, true
+	case "disk_usage":
+		return primaryDisk(metrics).UsedPercent, true
+	case "load1":
+		return metrics.LoadAverage.Load1, true
+	default:
+		return 0, false
+	}
+}
+
+func compare(value float64, op string, threshold float64) bool {
+	switch op {
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case "==":
+		return value == threshold
+	default:
+		return false
+	}
+}
+
+// alertActionTimeout bounds how long a single webhook POST or exec action is
+// allowed to run. Actions fire from monitorMetrics' sampling loop, so a
+// target that hangs must not be able to freeze the whole monitoring screen.
+const alertActionTimeout = 5 * time.Second
+
+var webhookClient = &http.Client{Timeout: alertActionTimeout}
+
+func fireAlert(rule *AlertRule, value float64, at time.Time) {
+	runAlertAction(rule, value, at, "firing")
+}
+
+func resolveAlert(rule *AlertRule, value float64, at time.Time) {
+	runAlertAction(rule, value, at, "resolved")
+}
+
+// runAlertAction dispatches the rule's configured action. Webhook and exec
+// actions run in their own goroutine (on top of their own timeout) so a
+// stuck network call or shell command can't block Evaluate's caller.
+func runAlertAction(rule *AlertRule, value float64, at time.Time, state string) {
+	switch rule.Action {
+	case "log":
+		fmt.Printf("[ALERT %s] %s %s %.2f (threshold %.2f, severity %s)\n",
+			state, rule.Metric, rule.Op, value, rule.Threshold, rule.Severity)
+	case "webhook":
+		go sendWebhook(rule, value, at, state)
+	case "exec":
+		go runExec(rule, value, at, state)
+	default:
+		fmt.Printf("Unknown alert action: %s\n", rule.Action)
+	}
+}
+
+func sendWebhook(rule *AlertRule, value float64, at time.Time, state string) {
+	hostname := "unknown"
+	if hostStat, err := host.Info(); err == nil {
+		hostname = hostStat.Hostname
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"rule":      rule.Metric,
+		"value":     value,
+		"threshold": rule.Threshold,
+		"severity":  rule.Severity,
+		"state":     state,
+		"timestamp": at,
+		"hostname":  hostname,
+	})
+	if err != nil {
+		fmt.Printf("Error building webhook payload: %v\n", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), alertActionTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Target, bytes.NewReader(payload))
+	if err != nil {
+		fmt.Printf("Error building webhook request: %v\n", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		fmt.Printf("Error sending webhook: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+}
+
+func runExec(rule *AlertRule, value float64, at time.Time, state string) {
+	ctx, cancel := context.WithTimeout(context.Background(), alertActionTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", rule.Target)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("ALERT_METRIC=%s", rule.Metric),
+		fmt.Sprintf("ALERT_VALUE=%.2f", value),
+		fmt.Sprintf("ALERT_STATE=%s", state),
+	)
+	if err := cmd.Run(); err != nil {
+		fmt.Printf("Error running alert exec action: %v\n", err)
+	}
+}
+
+func rulesMenu() {
+	for {
+		fmt.Println("\n=== Alert Rules ===")
+		if alertEngine == nil || len(alertEngine.Rules) == 0 {
+			fmt.Println("No rules configured")
+		} else {
+			for i, rule := range alertEngine.Rules {
+				status := "disabled"
+				if rule.Enabled {
+					status = "enabled"
+				}
+				fmt.Printf("%d. [%s] %s %s %.2f for %s -> %s (%s)\n",
+					i, status, rule.Metric, rule.Op, rule.Threshold, rule.ForDuration, rule.Action, rule.Severity)
+			}
+		}
+		fmt.Println("\n1. Enable rule")
+		fmt.Println("2. Disable rule")
+		fmt.Println("3. Back to Main Menu")
+		fmt.Print("Choose option (1-3): ")
+
+		var choice int
+		fmt.Scanf("%d", &choice)
+
+		switch choice {
+		case 1:
+			toggleRule(true)
+		case 2:
+			toggleRule(false)
+		case 3:
+			return
+		default:
+			fmt.Println("Invalid choice")
+		}
+	}
+}
+
+func toggleRule(enable bool) {
+	if alertEngine == nil {
+		fmt.Println("No rules loaded")
+		return
+	}
+
+	var index int
+	fmt.Print("Rule number: ")
+	fmt.Scanf("%d", &index)
+
+	if index < 0 || index >= len(alertEngine.Rules) {
+		fmt.Println("Invalid rule number")
+		return
+	}
+
+	alertEngine.Rules[index].Enabled = enable
+	if err := alertEngine.save(); err != nil {
+		fmt.Printf("Error saving rules: %v\n", err)
+	}
+}
+
+var alertEngine *AlertEngine
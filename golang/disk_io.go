@@ -0,0 +1,59 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/disk"
+)
+
+// DiskIOTracker computes per-device read/write throughput and IOPS from the
+// delta between successive disk.IOCounters samples.
+type DiskIOTracker struct {
+	mu        sync.Mutex
+	lastStats map[string]disk.IOCountersStat
+	lastTime  time.Time
+}
+
+func newDiskIOTracker() *DiskIOTracker {
+	return &DiskIOTracker{lastStats: make(map[string]disk.IOCountersStat)}
+}
+
+func (t *DiskIOTracker) Update() ([]DiskIOStat, error) {
+	counters, err := disk.IOCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var stats []DiskIOStat
+	if !t.lastTime.IsZero() {
+		elapsed := now.Sub(t.lastTime).Seconds()
+		if elapsed > 0 {
+			for name, current := range counters {
+				prev, ok := t.lastStats[name]
+				if !ok {
+					continue
+				}
+
+				stats = append(stats, DiskIOStat{
+					Device:        name,
+					ReadBytesSec:  (float64(current.ReadBytes) - float64(prev.ReadBytes)) / elapsed,
+					WriteBytesSec: (float64(current.WriteBytes) - float64(prev.WriteBytes)) / elapsed,
+					IOPS:          float64((current.ReadCount-prev.ReadCount)+(current.WriteCount-prev.WriteCount)) / elapsed,
+				})
+			}
+		}
+	}
+
+	t.lastStats = counters
+	t.lastTime = now
+
+	return stats, nil
+}
+
+var diskIOTracker = newDiskIOTracker()
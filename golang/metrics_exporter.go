@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// exporterSampleInterval is how often startExporter's own sampling goroutine
+// refreshes the registry, independent of whatever the metrics menu is doing.
+const exporterSampleInterval = 2 * time.Second
+
+// MetricsRegistry holds the most recent sample of system and task metrics
+// so the HTTP exporter can serve them without re-sampling on every scrape.
+type MetricsRegistry struct {
+	mu      sync.Mutex
+	Metrics *SystemMetrics
+	Tasks   *TaskManager
+
+	stop chan struct{}
+}
+
+func NewMetricsRegistry(tm *TaskManager) *MetricsRegistry {
+	return &MetricsRegistry{
+		Tasks: tm,
+	}
+}
+
+func (r *MetricsRegistry) Update(metrics *SystemMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Metrics = metrics
+}
+
+// startSampling runs until stop is closed, periodically taking its own
+// system metrics sample so the exporter has data to serve whether or not
+// anyone is sitting in "Monitor Continuously".
+func (r *MetricsRegistry) startSampling(stop chan struct{}) {
+	ticker := time.NewTicker(exporterSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if metrics, err := getSystemMetrics(); err == nil {
+				r.Update(metrics)
+			}
+		}
+	}
+}
+
+func (r *MetricsRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	metrics := r.Metrics
+	tasks := r.Tasks
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	if metrics != nil {
+		fmt.Fprintf(w, "# HELP cpu_usage Current CPU usage percentage\n")
+		fmt.Fprintf(w, "# TYPE cpu_usage gauge\n")
+		fmt.Fprintf(w, "cpu_usage %f\n", metrics.CPUUsage)
+
+		fmt.Fprintf(w, "# HELP mem_used_bytes Memory currently in use\n")
+		fmt.Fprintf(w, "# TYPE mem_used_bytes gauge\n")
+		fmt.Fprintf(w, "mem_used_bytes %d\n", metrics.Memory.Used)
+
+		fmt.Fprintf(w, "# HELP mem_total_bytes Total memory available\n")
+		fmt.Fprintf(w, "# TYPE mem_total_bytes gauge\n")
+		fmt.Fprintf(w, "mem_total_bytes %d\n", metrics.Memory.Total)
+
+		fmt.Fprintf(w, "# HELP disk_used_bytes Disk space currently in use, per mountpoint\n")
+		fmt.Fprintf(w, "# TYPE disk_used_bytes gauge\n")
+		for _, d := range metrics.Disk {
+			fmt.Fprintf(w, "disk_used_bytes{mountpoint=\"%s\"} %d\n", d.Mountpoint, d.Used)
+		}
+
+		fmt.Fprintf(w, "# HELP net_bytes_sent Total bytes sent over network interfaces\n")
+		fmt.Fprintf(w, "# TYPE net_bytes_sent counter\n")
+		fmt.Fprintf(w, "net_bytes_sent %d\n", metrics.Network.BytesSent)
+
+		fmt.Fprintf(w, "# HELP net_bytes_recv Total bytes received over network interfaces\n")
+		fmt.Fprintf(w, "# TYPE net_bytes_recv counter\n")
+		fmt.Fprintf(w, "net_bytes_recv %d\n", metrics.Network.BytesRecv)
+
+		fmt.Fprintf(w, "# HELP uptime_seconds System uptime in seconds\n")
+		fmt.Fprintf(w, "# TYPE uptime_seconds counter\n")
+		fmt.Fprintf(w, "uptime_seconds %f\n", metrics.System.Uptime.Seconds())
+	}
+
+	if tasks != nil {
+		counts := map[string]int{"pending": 0, "in-progress": 0, "completed": 0}
+		for _, task := range tasks.Snapshot() {
+			counts[task.Status]++
+		}
+
+		fmt.Fprintf(w, "# HELP tasks_total Number of tasks by status\n")
+		fmt.Fprintf(w, "# TYPE tasks_total gauge\n")
+		for status, count := range counts {
+			fmt.Fprintf(w, "tasks_total{status=\"%s\"} %d\n", status, count)
+		}
+	}
+}
+
+// startExporter launches the Prometheus exporter in the background, along
+// with its own sampling goroutine so the registry has data to serve even
+// when nothing else in the CLI is sampling metrics, and returns the server
+// so it can be shut down later.
+func startExporter(registry *MetricsRegistry, port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", registry)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+
+	registry.stop = make(chan struct{})
+	go registry.startSampling(registry.stop)
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("Exporter stopped: %v\n", err)
+		}
+	}()
+
+	return server
+}
+
+func exporterMenu(manager *TaskManager) {
+	for {
+		fmt.Println("\n=== Metrics Exporter ===")
+		if runningExporter == nil {
+			fmt.Println("Status: stopped")
+		} else {
+			fmt.Println("Status: running")
+		}
+		fmt.Println("1. Start exporter")
+		fmt.Println("2. Stop exporter")
+		fmt.Println("3. Back to Main Menu")
+		fmt.Print("Choose option (1-3): ")
+
+		var choice int
+		fmt.Scanf("%d", &choice)
+
+		switch choice {
+		case 1:
+			if runningExporter != nil {
+				fmt.Println("Exporter already running")
+				continue
+			}
+			var port int
+			fmt.Print("Port to listen on: ")
+			fmt.Scanf("%d", &port)
+
+			if exporterRegistry == nil {
+				exporterRegistry = NewMetricsRegistry(manager)
+			}
+			runningExporter = startExporter(exporterRegistry, port)
+			fmt.Printf("Exporter listening on :%d/metrics\n", port)
+		case 2:
+			if runningExporter == nil {
+				fmt.Println("Exporter is not running")
+				continue
+			}
+			runningExporter.Close()
+			runningExporter = nil
+			close(exporterRegistry.stop)
+			fmt.Println("Exporter stopped")
+		case 3:
+			return
+		default:
+			fmt.Println("Invalid choice")
+		}
+	}
+}
+
+var (
+	exporterRegistry *MetricsRegistry
+	runningExporter  *http.Server
+)
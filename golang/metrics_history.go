@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxHistorySize     = 300
+	metricsHistoryDir  = ".tasktracker"
+	metricsHistoryFile = "metrics.jsonl"
+)
+
+var metricsHistory *MetricsHistory
+
+// MetricsSample is one point in the metrics history, recorded each time
+// monitorMetrics takes a sample.
+type MetricsSample struct {
+	Timestamp time.Time `json:"timestamp"`
+	CPU       float64   `json:"cpu"`
+	RAM       float64   `json:"ram"`
+	Disk      float64   `json:"disk"`
+	NetTxRate float64   `json:"net_tx_rate"`
+	NetRxRate float64   `json:"net_rx_rate"`
+	Load1     float64   `json:"load1"`
+}
+
+// MetricsHistory is a bounded ring of recent samples used to render
+// sparklines and min/max/avg stats without re-querying the OS.
+type MetricsHistory struct {
+	mu      sync.Mutex
+	Samples []MetricsSample
+
+	lastNetSent uint64
+	lastNetRecv uint64
+	lastSample  time.Time
+}
+
+func loadMetricsHistory() *MetricsHistory {
+	history := &MetricsHistory{}
+
+	path, err := metricsHistoryPath()
+	if err != nil {
+		return history
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return history
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		var sample MetricsSample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			continue
+		}
+		history.Samples = append(history.Samples, sample)
+	}
+
+	if len(history.Samples) > maxHistorySize {
+		history.Samples = history.Samples[len(history.Samples)-maxHistorySize:]
+	}
+
+	return history
+}
+
+// Add computes the network transfer rate from the delta against the last
+// sample, appends the new sample to the ring, and persists it to disk.
+func (h *MetricsHistory) Add(metrics *SystemMetrics) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var txRate, rxRate float64
+	if !h.lastSample.IsZero() {
+		elapsed := metrics.LastUpdate.Sub(h.lastSample).Seconds()
+		if elapsed > 0 {
+			txRate = float64(metrics.Network.BytesSent-h.lastNetSent) / elapsed
+			rxRate = float64(metrics.Network.BytesRecv-h.lastNetRecv) / elapsed
+		}
+	}
+	h.lastNetSent = metrics.Network.BytesSent
+	h.lastNetRecv = metrics.Network.BytesRecv
+	h.lastSample = metrics.LastUpdate
+
+	sample := MetricsSample{
+		Timestamp: metrics.LastUpdate,
+		CPU:       metrics.CPUUsage,
+		RAM:       metrics.Memory.UsedPercent,
+		Disk:      primaryDisk(metrics).UsedPercent,
+		NetTxRate: txRate,
+		NetRxRate: rxRate,
+		Load1:     metrics.LoadAverage.Load1,
+	}
+
+	h.Samples = append(h.Samples, sample)
+	if len(h.Samples) > maxHistorySize {
+		h.Samples = h.Samples[len(h.Samples)-maxHistorySize:]
+	}
+
+	h.persistToDisk()
+}
+
+// persistToDisk rewrites metrics.jsonl from the in-memory ring, which is
+// already bounded to maxHistorySize. Rewriting (rather than appending
+// forever) keeps the file from growing without bound and keeps
+// loadMetricsHistory's startup read cheap.
+func (h *MetricsHistory) persistToDisk() {
+	path, err := metricsHistoryPath()
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	f, err := os.OpenFile(path, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	for _, sample := range h.Samples {
+		data, err := json.Marshal(sample)
+		if err != nil {
+			continue
+		}
+		f.Write(append(data, '\n'))
+	}
+}
+
+func metricsHistoryPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, metricsHistoryDir, metricsHistoryFile), nil
+}
+
+// Window returns a copy of up to the last n samples.
+func (h *MetricsHistory) Window(n int) []MetricsSample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if n <= 0 || n > len(h.Samples) {
+		n = len(h.Samples)
+	}
+
+	window := make([]MetricsSample, n)
+	copy(window, h.Samples[len(h.Samples)-n:])
+	return window
+}
+
+func minMaxAvg(values []float64) (min, max, avg float64) {
+	if len(values) == 0 {
+		return 0, 0, 0
+	}
+
+	min, max = values[0], values[0]
+	var sum float64
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		sum += v
+	}
+
+	return min, max, sum / float64(len(values))
+}
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+func renderSparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max, _ := minMaxAvg(values)
+	spread := max - min
+
+	runes := make([]rune, len(values))
+	for i, v := range values {
+		if spread == 0 {
+			runes[i] = sparkBlocks[0]
+			continue
+		}
+		idx := int((v - min) / spread * float64(len(sparkBlocks)-1))
+		runes[i] = sparkBlocks[idx]
+	}
+
+	return string(runes)
+}
+
+// printHistory renders a sparkline plus min/max/avg for each tracked
+// metric over the most recent window of samples.
+func printHistory(history *MetricsHistory) {
+	if history == nil {
+		return
+	}
+
+	window := history.Window(maxHistorySize)
+	if len(window) == 0 {
+		return
+	}
+
+	cpu := make([]float64, len(window))
+	ram := make([]float64, len(window))
+	disk := make([]float64, len(window))
+	tx := make([]float64, len(window))
+	rx := make([]float64, len(window))
+	load1 := make([]float64, len(window))
+	for i, s := range window {
+		cpu[i] = s.CPU
+		ram[i] = s.RAM
+		disk[i] = s.Disk
+		tx[i] = s.NetTxRate
+		rx[i] = s.NetRxRate
+		load1[i] = s.Load1
+	}
+
+	fmt.Printf("\nHistory (%d samples):\n", len(window))
+	printSparklineRow("CPU", cpu, "%%")
+	printSparklineRow("RAM", ram, "%%")
+	printSparklineRow("Disk", disk, "%%")
+	printSparklineRow("Net TX", tx, "B/s")
+	printSparklineRow("Net RX", rx, "B/s")
+	printSparklineRow("Load1", load1, "")
+}
+
+func printSparklineRow(label string, values []float64, unit string) {
+	min, max, avg := minMaxAvg(values)
+	fmt.Printf("  %-7s %s  min=%.2f%s max=%.2f%s avg=%.2f%s\n",
+		label, renderSparkline(values), min, unit, max, unit, avg, unit)
+}
+
+func exportHistoryMenu() {
+	if metricsHistory == nil || len(metricsHistory.Samples) == 0 {
+		fmt.Println("No history to export yet")
+		return
+	}
+
+	var format string
+	fmt.Print("Export format (csv/json): ")
+	fmt.Scanf("%s", &format)
+
+	var path string
+	fmt.Print("Output file path: ")
+	fmt.Scanf("%s", &path)
+
+	if err := exportHistory(metricsHistory, format, path); err != nil {
+		fmt.Printf("Error exporting history: %v\n", err)
+		return
+	}
+
+	fmt.Printf("History exported to %s\n", path)
+}
+
+func exportHistory(history *MetricsHistory, format string, path string) error {
+	window := history.Window(maxHistorySize)
+
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(window, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, data, 0644)
+	case "csv":
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w := csv.NewWriter(f)
+		defer w.Flush()
+
+		w.Write([]string{"timestamp", "cpu", "ram", "disk", "net_tx_rate", "net_rx_rate", "load1"})
+		for _, s := range window {
+			w.Write([]string{
+				s.Timestamp.Format(time.RFC3339),
+				fmt.Sprintf("%f", s.CPU),
+				fmt.Sprintf("%f", s.RAM),
+				fmt.Sprintf("%f", s.Disk),
+				fmt.Sprintf("%f", s.NetTxRate),
+				fmt.Sprintf("%f", s.NetRxRate),
+				fmt.Sprintf("%f", s.Load1),
+			})
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown export format: %s", format)
+	}
+}
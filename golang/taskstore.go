@@ -0,0 +1,257 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+const taskDBFile = "tasks.db"
+
+// TaskStore abstracts task persistence so the SQLite-backed implementation
+// can be swapped out (e.g. in tests) without touching the menu code.
+type TaskStore interface {
+	LoadAll() ([]Task, error)
+	Insert(task *Task) error
+	Update(task Task) error
+	Delete(id int) error
+	Search(query string) ([]Task, error)
+	Filter(status, tag string, priority *int, sortByDue bool) ([]Task, error)
+	ExportJSON(path string) error
+	Close() error
+}
+
+// SQLiteTaskStore is the default TaskStore, backed by modernc.org/sqlite so
+// the binary stays cgo-free.
+type SQLiteTaskStore struct {
+	db *sql.DB
+}
+
+func taskStorePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return taskDBFile
+	}
+	return filepath.Join(home, metricsHistoryDir, taskDBFile)
+}
+
+func newSQLiteTaskStore(path string) (*SQLiteTaskStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open task store: %w", err)
+	}
+
+	store := &SQLiteTaskStore{db: db}
+	if err := store.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *SQLiteTaskStore) migrate() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS tasks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			description TEXT,
+			status TEXT NOT NULL,
+			due_at DATETIME,
+			priority INTEGER NOT NULL DEFAULT 0,
+			tags TEXT,
+			parent INTEGER NOT NULL DEFAULT 0,
+			recurrence TEXT,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks(status)`,
+		`CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks(priority)`,
+		`CREATE INDEX IF NOT EXISTS idx_tasks_due_at ON tasks(due_at)`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS tasks_fts USING fts5(title, description, content='tasks', content_rowid='id')`,
+		`CREATE TRIGGER IF NOT EXISTS tasks_ai AFTER INSERT ON tasks BEGIN
+			INSERT INTO tasks_fts(rowid, title, description) VALUES (new.id, new.title, new.description);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS tasks_ad AFTER DELETE ON tasks BEGIN
+			INSERT INTO tasks_fts(tasks_fts, rowid, title, description) VALUES('delete', old.id, old.title, old.description);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS tasks_au AFTER UPDATE ON tasks BEGIN
+			INSERT INTO tasks_fts(tasks_fts, rowid, title, description) VALUES('delete', old.id, old.title, old.description);
+			INSERT INTO tasks_fts(rowid, title, description) VALUES (new.id, new.title, new.description);
+		END`,
+	}
+
+	for _, stmt := range statements {
+		if _, err := s.db.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to migrate task store: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *SQLiteTaskStore) Insert(task *Task) error {
+	res, err := s.db.Exec(
+		`INSERT INTO tasks (title, description, status, due_at, priority, tags, parent, recurrence, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		task.Title, task.Description, task.Status, nullableTime(task.DueAt), task.Priority,
+		strings.Join(task.Tags, ","), task.Parent, task.Recurrence, task.CreatedAt, task.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert task: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to read new task id: %w", err)
+	}
+	task.ID = int(id)
+
+	return nil
+}
+
+func (s *SQLiteTaskStore) Update(task Task) error {
+	_, err := s.db.Exec(
+		`UPDATE tasks SET title = ?, description = ?, status = ?, due_at = ?, priority = ?,
+		 tags = ?, parent = ?, recurrence = ?, updated_at = ? WHERE id = ?`,
+		task.Title, task.Description, task.Status, nullableTime(task.DueAt), task.Priority,
+		strings.Join(task.Tags, ","), task.Parent, task.Recurrence, task.UpdatedAt, task.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteTaskStore) Delete(id int) error {
+	if _, err := s.db.Exec(`DELETE FROM tasks WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteTaskStore) LoadAll() ([]Task, error) {
+	rows, err := s.db.Query(`SELECT id, title, description, status, due_at, priority, tags, parent, recurrence, created_at, updated_at FROM tasks ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+func (s *SQLiteTaskStore) Search(query string) ([]Task, error) {
+	rows, err := s.db.Query(
+		`SELECT tasks.id, tasks.title, tasks.description, tasks.status, tasks.due_at, tasks.priority,
+		 tasks.tags, tasks.parent, tasks.recurrence, tasks.created_at, tasks.updated_at
+		 FROM tasks JOIN tasks_fts ON tasks.id = tasks_fts.rowid
+		 WHERE tasks_fts MATCH ? ORDER BY rank`, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+func (s *SQLiteTaskStore) Filter(status, tag string, priority *int, sortByDue bool) ([]Task, error) {
+	query := `SELECT id, title, description, status, due_at, priority, tags, parent, recurrence, created_at, updated_at FROM tasks WHERE 1=1`
+	var args []interface{}
+
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	if tag != "" {
+		query += ` AND (',' || tags || ',') LIKE ? ESCAPE '\'`
+		args = append(args, "%,"+escapeLike(tag)+",%")
+	}
+	if priority != nil {
+		query += ` AND priority = ?`
+		args = append(args, *priority)
+	}
+	if sortByDue {
+		query += ` ORDER BY due_at IS NULL, due_at ASC`
+	} else {
+		query += ` ORDER BY id`
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter tasks: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+// escapeLike escapes SQLite LIKE wildcards (%, _) and the escape character
+// itself so a tag containing them is matched literally rather than as a
+// pattern. Pair with an `ESCAPE '\'` clause on the LIKE expression.
+func escapeLike(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "%", `\%`)
+	s = strings.ReplaceAll(s, "_", `\_`)
+	return s
+}
+
+func (s *SQLiteTaskStore) ExportJSON(path string) error {
+	tasks, err := s.LoadAll()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal tasks: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func (s *SQLiteTaskStore) Close() error {
+	return s.db.Close()
+}
+
+func scanTasks(rows *sql.Rows) ([]Task, error) {
+	var tasks []Task
+	for rows.Next() {
+		var task Task
+		var tags sql.NullString
+		var dueAt sql.NullTime
+
+		if err := rows.Scan(&task.ID, &task.Title, &task.Description, &task.Status, &dueAt,
+			&task.Priority, &tags, &task.Parent, &task.Recurrence, &task.CreatedAt, &task.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		if dueAt.Valid {
+			due := dueAt.Time
+			task.DueAt = &due
+		}
+		if tags.Valid && tags.String != "" {
+			task.Tags = strings.Split(tags.String, ",")
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+func nullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
@@ -5,33 +5,59 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/disk"
 	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/load"
 	"github.com/shirou/gopsutil/mem"
 	"github.com/shirou/gopsutil/net"
+	"github.com/shirou/gopsutil/process"
 )
 
 type Task struct {
-	ID          int       `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"` // pending, in-progress, completed
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"` // pending, in-progress, completed
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Priority    int        `json:"priority"`
+	Tags        []string   `json:"tags,omitempty"`
+	Parent      int        `json:"parent,omitempty"`     // 0 if top-level
+	Recurrence  string     `json:"recurrence,omitempty"` // "", "daily", "weekly", "monthly"
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 type SystemMetrics struct {
-	CPUUsage     float64
-	Memory       MemoryInfo
-	Disk         DiskInfo
-	Network      NetworkInfo
-	System       SystemInfo
-	LastUpdate   time.Time
+	CPUUsage    float64
+	PerCPUUsage []float64
+	LoadAverage LoadAverage
+	Memory      MemoryInfo
+	Disk        []DiskInfo
+	DiskIO      []DiskIOStat
+	Network     NetworkInfo
+	System      SystemInfo
+	LastUpdate  time.Time
+}
+
+type LoadAverage struct {
+	Load1  float64
+	Load5  float64
+	Load15 float64
+}
+
+type ProcessInfo struct {
+	PID    int32
+	Name   string
+	CPUPct float64
+	RSSMB  float64
 }
 
 type MemoryInfo struct {
@@ -41,11 +67,21 @@ type MemoryInfo struct {
 }
 
 type DiskInfo struct {
+	Mountpoint  string
+	Device      string
+	Fstype      string
 	UsedPercent float64
 	Used        uint64
 	Total       uint64
 }
 
+type DiskIOStat struct {
+	Device        string
+	ReadBytesSec  float64
+	WriteBytesSec float64
+	IOPS          float64
+}
+
 type NetworkInfo struct {
 	BytesSent uint64
 	BytesRecv uint64
@@ -61,21 +97,39 @@ type SystemInfo struct {
 }
 
 type TaskManager struct {
+	mu    sync.Mutex
 	Tasks []Task
+	store TaskStore
+}
+
+// Snapshot returns a copy of the current task list, safe to read
+// concurrently with the task-mutating menu actions. The metrics exporter's
+// HTTP handler uses this instead of ranging over Tasks directly, since that
+// slice is appended to and re-sliced from the task menu's goroutine.
+func (tm *TaskManager) Snapshot() []Task {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tasks := make([]Task, len(tm.Tasks))
+	copy(tasks, tm.Tasks)
+	return tasks
 }
 
 func main() {
 	manager := &TaskManager{}
 	manager.loadTasks()
-	
+	metricsHistory = loadMetricsHistory()
+	alertEngine = loadAlertRules()
+
 	fmt.Println("=== TaskTracker CLI with System Metrics ===")
 	
 	for {
 		fmt.Println("\n=== Main Menu ===")
 		fmt.Println("1. Task Management")
 		fmt.Println("2. System Metrics")
-		fmt.Println("3. Exit")
-		fmt.Print("Choose option (1-3): ")
+		fmt.Println("3. Metrics Exporter")
+		fmt.Println("4. Alert Rules")
+		fmt.Println("5. Exit")
+		fmt.Print("Choose option (1-5): ")
 		
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -86,6 +140,10 @@ func main() {
 		case 2:
 			metricsMenu()
 		case 3:
+			exporterMenu(manager)
+		case 4:
+			rulesMenu()
+		case 5:
 			manager.saveTasks()
 			return
 		default:
@@ -101,8 +159,10 @@ func taskMenu(manager *TaskManager) {
 		fmt.Println("2. List Tasks")
 		fmt.Println("3. Update Task")
 		fmt.Println("4. Delete Task")
-		fmt.Println("5. Back to Main Menu")
-		fmt.Print("Choose option (1-5): ")
+		fmt.Println("5. Search Tasks")
+		fmt.Println("6. Filter Tasks")
+		fmt.Println("7. Back to Main Menu")
+		fmt.Print("Choose option (1-7): ")
 		
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -117,6 +177,10 @@ func taskMenu(manager *TaskManager) {
 		case 4:
 			manager.deleteTask()
 		case 5:
+			manager.searchTasks()
+		case 6:
+			manager.filterTasks()
+		case 7:
 			return
 		default:
 			fmt.Println("Invalid choice")
@@ -129,8 +193,10 @@ func metricsMenu() {
 		fmt.Println("\n=== System Metrics ===")
 		fmt.Println("1. View Current Metrics")
 		fmt.Println("2. Monitor Continuously")
-		fmt.Println("3. Back to Main Menu")
-		fmt.Print("Choose option (1-3): ")
+		fmt.Println("3. Top Processes")
+		fmt.Println("4. Export History")
+		fmt.Println("5. Back to Main Menu")
+		fmt.Print("Choose option (1-5): ")
 		
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -141,6 +207,10 @@ func metricsMenu() {
 		case 2:
 			monitorMetrics()
 		case 3:
+			viewTopProcesses()
+		case 4:
+			exportHistoryMenu()
+		case 5:
 			return
 		default:
 			fmt.Println("Invalid choice")
@@ -150,36 +220,140 @@ func metricsMenu() {
 
 func (tm *TaskManager) addTask() {
 	scanner := bufio.NewScanner(os.Stdin)
-	
+
 	fmt.Print("Task title: ")
 	scanner.Scan()
 	title := scanner.Text()
-	
+
 	fmt.Print("Description: ")
 	scanner.Scan()
 	description := scanner.Text()
-	
+
+	fmt.Print("Tags (comma-separated, optional): ")
+	scanner.Scan()
+	tags := parseTags(scanner.Text())
+
+	fmt.Print("Due date (YYYY-MM-DD, optional): ")
+	scanner.Scan()
+	dueAt := parseDueDate(scanner.Text())
+
+	fmt.Print("Priority (integer, optional, default 0): ")
+	scanner.Scan()
+	priority := parsePriority(scanner.Text())
+
+	fmt.Print("Recurrence (daily/weekly/monthly, optional): ")
+	scanner.Scan()
+	recurrence := parseRecurrence(scanner.Text())
+
 	task := Task{
-		ID:          len(tm.Tasks) + 1,
 		Title:       title,
 		Description: description,
 		Status:      "pending",
+		Tags:        tags,
+		DueAt:       dueAt,
+		Priority:    priority,
+		Recurrence:  recurrence,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
-	
+
+	tm.mu.Lock()
+	if tm.store != nil {
+		if err := tm.store.Insert(&task); err != nil {
+			tm.mu.Unlock()
+			fmt.Printf("Error saving task: %v\n", err)
+			return
+		}
+	} else {
+		task.ID = nextTaskID(tm.Tasks)
+	}
+
 	tm.Tasks = append(tm.Tasks, task)
+	tm.mu.Unlock()
 	fmt.Println("Task added successfully!")
 }
 
+func nextTaskID(tasks []Task) int {
+	max := 0
+	for _, task := range tasks {
+		if task.ID > max {
+			max = task.ID
+		}
+	}
+	return max + 1
+}
+
+func parseTags(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(raw, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// parseDueDate parses a YYYY-MM-DD due date, returning nil if raw is blank
+// or malformed.
+func parseDueDate(raw string) *time.Time {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	due, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		fmt.Printf("Invalid due date %q, leaving unset\n", raw)
+		return nil
+	}
+	return &due
+}
+
+// parsePriority parses an integer priority, returning 0 if raw is blank or
+// malformed.
+func parsePriority(raw string) int {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0
+	}
+
+	priority, err := strconv.Atoi(raw)
+	if err != nil {
+		fmt.Printf("Invalid priority %q, defaulting to 0\n", raw)
+		return 0
+	}
+	return priority
+}
+
+// parseRecurrence validates a recurrence value against the rules
+// scheduleNextOccurrence understands, returning "" if raw is blank or
+// unrecognized.
+func parseRecurrence(raw string) string {
+	raw = strings.TrimSpace(raw)
+	switch raw {
+	case "daily", "weekly", "monthly":
+		return raw
+	case "":
+		return ""
+	default:
+		fmt.Printf("Unknown recurrence %q, leaving unset\n", raw)
+		return ""
+	}
+}
+
 func (tm *TaskManager) listTasks() {
-	if len(tm.Tasks) == 0 {
+	tasks := tm.Snapshot()
+	if len(tasks) == 0 {
 		fmt.Println("No tasks available")
 		return
 	}
-	
+
 	fmt.Println("\nYour Tasks:")
-	for _, task := range tm.Tasks {
+	for _, task := range tasks {
 		status := getStatusSymbol(task.Status)
 		fmt.Printf("[%d] %s %s\n", task.ID, status, task.Title)
 		fmt.Printf("    Status: %s\n", task.Status)
@@ -211,11 +385,45 @@ func (tm *TaskManager) updateTask() {
 			fmt.Print("New status (pending/in-progress/completed): ")
 			var status string
 			fmt.Scanf("%s", &status)
-			
+
 			switch status {
 			case "pending", "in-progress", "completed":
+				fmt.Print("New due date (YYYY-MM-DD, blank to leave unchanged): ")
+				var dueRaw string
+				fmt.Scanf("%s", &dueRaw)
+
+				fmt.Print("New priority (integer, blank to leave unchanged): ")
+				var priorityRaw string
+				fmt.Scanf("%s", &priorityRaw)
+
+				fmt.Print("New recurrence (daily/weekly/monthly, blank to leave unchanged): ")
+				var recurrenceRaw string
+				fmt.Scanf("%s", &recurrenceRaw)
+
+				tm.mu.Lock()
 				tm.Tasks[i].Status = status
+				if dueRaw != "" {
+					tm.Tasks[i].DueAt = parseDueDate(dueRaw)
+				}
+				if priorityRaw != "" {
+					tm.Tasks[i].Priority = parsePriority(priorityRaw)
+				}
+				if recurrenceRaw != "" {
+					tm.Tasks[i].Recurrence = parseRecurrence(recurrenceRaw)
+				}
 				tm.Tasks[i].UpdatedAt = time.Now()
+				if tm.store != nil {
+					if err := tm.store.Update(tm.Tasks[i]); err != nil {
+						tm.mu.Unlock()
+						fmt.Printf("Error saving task: %v\n", err)
+						return
+					}
+				}
+				updated := tm.Tasks[i]
+				tm.mu.Unlock()
+				if status == "completed" && updated.Recurrence != "" {
+					tm.scheduleNextOccurrence(updated)
+				}
 				fmt.Println("Task updated successfully!")
 				return
 			default:
@@ -224,44 +432,252 @@ func (tm *TaskManager) updateTask() {
 			}
 		}
 	}
-	
+
 	fmt.Println("Task not found")
 }
 
+// scheduleNextOccurrence creates the next instance of a recurring task once
+// its current occurrence is completed.
+func (tm *TaskManager) scheduleNextOccurrence(completed Task) {
+	next := nextDueDate(completed.DueAt, completed.Recurrence)
+	if next == nil {
+		return
+	}
+
+	task := Task{
+		Title:       completed.Title,
+		Description: completed.Description,
+		Status:      "pending",
+		DueAt:       next,
+		Priority:    completed.Priority,
+		Tags:        completed.Tags,
+		Parent:      completed.Parent,
+		Recurrence:  completed.Recurrence,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	tm.mu.Lock()
+	if tm.store != nil {
+		if err := tm.store.Insert(&task); err != nil {
+			tm.mu.Unlock()
+			fmt.Printf("Error scheduling next occurrence: %v\n", err)
+			return
+		}
+	} else {
+		task.ID = nextTaskID(tm.Tasks)
+	}
+
+	tm.Tasks = append(tm.Tasks, task)
+	tm.mu.Unlock()
+	fmt.Printf("Scheduled next occurrence for %s on %s\n", task.Title, next.Format("2006-01-02"))
+}
+
+func nextDueDate(from *time.Time, recurrence string) *time.Time {
+	base := time.Now()
+	if from != nil {
+		base = *from
+	}
+
+	var next time.Time
+	switch recurrence {
+	case "daily":
+		next = base.AddDate(0, 0, 1)
+	case "weekly":
+		next = base.AddDate(0, 0, 7)
+	case "monthly":
+		next = base.AddDate(0, 1, 0)
+	default:
+		return nil
+	}
+
+	return &next
+}
+
 func (tm *TaskManager) deleteTask() {
 	var id int
 	fmt.Print("Enter task ID to delete: ")
 	fmt.Scanf("%d", &id)
 	
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
 	for i, task := range tm.Tasks {
 		if task.ID == id {
+			if tm.store != nil {
+				if err := tm.store.Delete(id); err != nil {
+					fmt.Printf("Error deleting task: %v\n", err)
+					return
+				}
+			}
 			tm.Tasks = append(tm.Tasks[:i], tm.Tasks[i+1:]...)
 			fmt.Println("Task deleted!")
 			return
 		}
 	}
-	
+
 	fmt.Println("Task not found")
 }
 
+func (tm *TaskManager) searchTasks() {
+	if tm.store == nil {
+		fmt.Println("Search requires the SQLite task store")
+		return
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("Search query: ")
+	scanner.Scan()
+	query := scanner.Text()
+
+	tasks, err := tm.store.Search(query)
+	if err != nil {
+		fmt.Printf("Error searching tasks: %v\n", err)
+		return
+	}
+
+	printTaskResults(tasks)
+}
+
+func (tm *TaskManager) filterTasks() {
+	if tm.store == nil {
+		fmt.Println("Filtering requires the SQLite task store")
+		return
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("Status (blank for any): ")
+	scanner.Scan()
+	status := scanner.Text()
+
+	fmt.Print("Tag (blank for any): ")
+	scanner.Scan()
+	tag := scanner.Text()
+
+	fmt.Print("Priority (blank for any): ")
+	scanner.Scan()
+	var priority *int
+	if raw := strings.TrimSpace(scanner.Text()); raw != "" {
+		p := parsePriority(raw)
+		priority = &p
+	}
+
+	fmt.Print("Sort by due date? (y/n): ")
+	scanner.Scan()
+	sortByDue := strings.EqualFold(strings.TrimSpace(scanner.Text()), "y")
+
+	tasks, err := tm.store.Filter(status, tag, priority, sortByDue)
+	if err != nil {
+		fmt.Printf("Error filtering tasks: %v\n", err)
+		return
+	}
+
+	printTaskResults(tasks)
+}
+
+func printTaskResults(tasks []Task) {
+	if len(tasks) == 0 {
+		fmt.Println("No matching tasks")
+		return
+	}
+
+	fmt.Println("\nMatching Tasks:")
+	for _, task := range tasks {
+		status := getStatusSymbol(task.Status)
+		fmt.Printf("[%d] %s %s\n", task.ID, status, task.Title)
+		fmt.Printf("    Status: %s\n", task.Status)
+		if len(task.Tags) > 0 {
+			fmt.Printf("    Tags: %s\n", strings.Join(task.Tags, ", "))
+		}
+		if task.DueAt != nil {
+			fmt.Printf("    Due: %s\n", task.DueAt.Format("2006-01-02 15:04"))
+		}
+		fmt.Println()
+	}
+}
+
+// loadTasks opens the SQLite-backed task store and populates the in-memory
+// cache used by the menu functions. It falls back to the legacy tasks.json
+// blob if the store can't be opened, e.g. when sqlite isn't available. On a
+// fresh store it migrates an existing tasks.json in once, so upgrading users
+// don't lose their task list.
 func (tm *TaskManager) loadTasks() {
+	store, err := newSQLiteTaskStore(taskStorePath())
+	if err != nil {
+		fmt.Printf("Error opening task store, falling back to tasks.json: %v\n", err)
+		tm.loadTasksJSON()
+		return
+	}
+
+	tasks, err := store.LoadAll()
+	if err != nil {
+		fmt.Printf("Error loading tasks: %v\n", err)
+		return
+	}
+
+	if len(tasks) == 0 {
+		tasks = migrateTasksJSON(store)
+	}
+
+	tm.store = store
+	tm.Tasks = tasks
+}
+
+// migrateTasksJSON imports a legacy tasks.json into store the first time the
+// SQLite store is opened empty, so existing tasks survive the upgrade.
+func migrateTasksJSON(store TaskStore) []Task {
+	data, err := os.ReadFile("tasks.json")
+	if err != nil {
+		return nil
+	}
+
+	var legacy []Task
+	if err := json.Unmarshal(data, &legacy); err != nil || len(legacy) == 0 {
+		return nil
+	}
+
+	migrated := make([]Task, 0, len(legacy))
+	for _, task := range legacy {
+		task.ID = 0
+		if err := store.Insert(&task); err != nil {
+			fmt.Printf("Error migrating task %q: %v\n", task.Title, err)
+			continue
+		}
+		migrated = append(migrated, task)
+	}
+
+	fmt.Printf("Migrated %d task(s) from tasks.json into the SQLite store\n", len(migrated))
+	return migrated
+}
+
+func (tm *TaskManager) loadTasksJSON() {
 	data, err := os.ReadFile("tasks.json")
 	if err != nil {
 		return // File doesn't exist yet
 	}
-	
+
 	json.Unmarshal(data, &tm.Tasks)
 }
 
+// saveTasks exports the current task set to tasks.json for compatibility
+// with tools that still expect the old flat-file format, then closes the
+// store. Mutations are already persisted to SQLite as they happen.
 func (tm *TaskManager) saveTasks() {
-	data, err := json.MarshalIndent(tm.Tasks, "", "  ")
-	if err != nil {
-		fmt.Printf("Error marshaling tasks: %v\n", err)
+	if tm.store == nil {
+		data, err := json.MarshalIndent(tm.Tasks, "", "  ")
+		if err != nil {
+			fmt.Printf("Error marshaling tasks: %v\n", err)
+			return
+		}
+		if err := os.WriteFile("tasks.json", data, 0644); err != nil {
+			fmt.Printf("Error writing tasks to file: %v\n", err)
+		}
 		return
 	}
-	if err := os.WriteFile("tasks.json", data, 0644); err != nil {
-		fmt.Printf("Error writing tasks to file: %v\n", err)
+
+	if err := tm.store.ExportJSON("tasks.json"); err != nil {
+		fmt.Printf("Error exporting tasks to JSON: %v\n", err)
 	}
+	tm.store.Close()
 }
 
 func viewMetrics() {
@@ -297,22 +713,94 @@ func monitorMetrics() {
 			continue
 		}
 		
+		if exporterRegistry != nil {
+			exporterRegistry.Update(metrics)
+		}
+		if metricsHistory != nil {
+			metricsHistory.Add(metrics)
+		}
+		if alertEngine != nil {
+			alertEngine.Evaluate(metrics)
+		}
+
 		clearScreen()
 		printMetrics(metrics)
+		printHistory(metricsHistory)
 		time.Sleep(2 * time.Second)
 	}
 }
 
+func getDiskInfo() ([]DiskInfo, error) {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disk partitions: %w", err)
+	}
+
+	var infos []DiskInfo
+	for _, p := range partitions {
+		if isPseudoFilesystem(p.Fstype) {
+			continue
+		}
+
+		usage, err := disk.Usage(p.Mountpoint)
+		if err != nil {
+			continue
+		}
+
+		infos = append(infos, DiskInfo{
+			Mountpoint:  p.Mountpoint,
+			Device:      p.Device,
+			Fstype:      p.Fstype,
+			UsedPercent: usage.UsedPercent,
+			Used:        usage.Used,
+			Total:       usage.Total,
+		})
+	}
+
+	return infos, nil
+}
+
+func isPseudoFilesystem(fstype string) bool {
+	switch fstype {
+	case "tmpfs", "devtmpfs", "proc", "sysfs", "cgroup", "cgroup2", "overlay",
+		"squashfs", "devpts", "autofs", "debugfs", "tracefs", "mqueue", "pstore",
+		"securityfs", "binfmt_misc":
+		return true
+	default:
+		return false
+	}
+}
+
 func getSystemMetrics() (*SystemMetrics, error) {
 	var metrics SystemMetrics
 	
-	// CPU usage
-	cpuPercent, err := cpu.Percent(time.Second, false)
+	// Per-core CPU usage; the aggregate is derived from it below so we only
+	// pay for one blocking 1-second sample instead of two.
+	perCPUPercent, err := cpu.Percent(time.Second, true)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get CPU usage: %w", err)
+		return nil, fmt.Errorf("failed to get per-CPU usage: %w", err)
 	}
-	metrics.CPUUsage = cpuPercent[0]
-	
+	metrics.PerCPUUsage = perCPUPercent
+
+	var cpuTotal float64
+	for _, pct := range perCPUPercent {
+		cpuTotal += pct
+	}
+	if len(perCPUPercent) > 0 {
+		metrics.CPUUsage = cpuTotal / float64(len(perCPUPercent))
+	}
+
+	// Load averages
+	loadStat, err := load.Avg()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get load averages: %w", err)
+	}
+	metrics.LoadAverage = LoadAverage{
+		Load1:  loadStat.Load1,
+		Load5:  loadStat.Load5,
+		Load15: loadStat.Load15,
+	}
+
 	// Memory info
 	vmStat, err := mem.VirtualMemory()
 	if err != nil {
@@ -324,17 +812,21 @@ func getSystemMetrics() (*SystemMetrics, error) {
 		Total:       vmStat.Total,
 	}
 	
-	// Disk info (root partition)
-	diskStat, err := disk.Usage("/")
+	// Disk info (every real partition, not just the root filesystem)
+	diskInfos, err := getDiskInfo()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get disk stats: %w", err)
 	}
-	metrics.Disk = DiskInfo{
-		UsedPercent: diskStat.UsedPercent,
-		Used:        diskStat.Used,
-		Total:       diskStat.Total,
+	metrics.Disk = diskInfos
+
+	// Disk I/O rates, computed from the delta against the previous sample
+	diskIO, err := diskIOTracker.Update()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk IO stats: %w", err)
 	}
-	
+	metrics.DiskIO = diskIO
+
+
 	// Network info
 	netStats, err := net.IOCounters(false)
 	if err != nil {
@@ -373,7 +865,11 @@ func printMetrics(metrics *SystemMetrics) {
 	
 	// CPU
 	fmt.Printf("CPU Usage: %.2f%%\n", metrics.CPUUsage)
-	
+	fmt.Printf("Load Average: %.2f, %.2f, %.2f (1m, 5m, 15m)\n",
+		metrics.LoadAverage.Load1,
+		metrics.LoadAverage.Load5,
+		metrics.LoadAverage.Load15)
+
 	// Memory
 	fmt.Printf("RAM Usage: %.2f%% (%s / %s)\n",
 		metrics.Memory.UsedPercent,
@@ -381,11 +877,17 @@ func printMetrics(metrics *SystemMetrics) {
 		formatBytes(metrics.Memory.Total))
 	
 	// Disk
-	fmt.Printf("Disk Usage: %.2f%% (%s / %s)\n",
-		metrics.Disk.UsedPercent,
-		formatBytes(metrics.Disk.Used),
-		formatBytes(metrics.Disk.Total))
-	
+	for _, d := range metrics.Disk {
+		fmt.Printf("Disk Usage (%s, %s): %.2f%% (%s / %s)\n",
+			d.Mountpoint, d.Fstype, d.UsedPercent,
+			formatBytes(d.Used), formatBytes(d.Total))
+	}
+	if busiest, ok := busiestDiskIO(metrics.DiskIO); ok {
+		fmt.Printf("Busiest disk: %s (%.1f IOPS, %s/s read, %s/s write)\n",
+			busiest.Device, busiest.IOPS,
+			formatBytes(nonNegativeBytes(busiest.ReadBytesSec)), formatBytes(nonNegativeBytes(busiest.WriteBytesSec)))
+	}
+
 	// Network
 	fmt.Printf("Network: %d interfaces, %s sent, %s received\n",
 		metrics.Network.Count,
@@ -400,8 +902,137 @@ func printMetrics(metrics *SystemMetrics) {
 	
 	// Visual indicators
 	fmt.Printf("\nCPU: %s\n", getVisualBar(metrics.CPUUsage))
+	for i, pct := range metrics.PerCPUUsage {
+		fmt.Printf("  Core %d: %s\n", i, getVisualBar(pct))
+	}
 	fmt.Printf("RAM: %s\n", getVisualBar(metrics.Memory.UsedPercent))
-	fmt.Printf("DISK: %s\n", getVisualBar(metrics.Disk.UsedPercent))
+	for _, d := range metrics.Disk {
+		fmt.Printf("DISK %s: %s\n", d.Mountpoint, getVisualBar(d.UsedPercent))
+	}
+}
+
+// primaryDisk returns the root filesystem's DiskInfo, falling back to the
+// first reported partition, for callers that only care about one number.
+func primaryDisk(metrics *SystemMetrics) DiskInfo {
+	for _, d := range metrics.Disk {
+		if d.Mountpoint == "/" {
+			return d
+		}
+	}
+	if len(metrics.Disk) > 0 {
+		return metrics.Disk[0]
+	}
+	return DiskInfo{}
+}
+
+func busiestDiskIO(stats []DiskIOStat) (DiskIOStat, bool) {
+	var busiest DiskIOStat
+	found := false
+
+	for _, s := range stats {
+		if !found || s.IOPS > busiest.IOPS {
+			busiest = s
+			found = true
+		}
+	}
+
+	return busiest, found
+}
+
+func viewTopProcesses() {
+	var topN int
+	fmt.Print("Number of processes to show: ")
+	fmt.Scanf("%d", &topN)
+	if topN <= 0 {
+		topN = 10
+	}
+
+	var sortBy string
+	fmt.Print("Sort by (cpu/mem): ")
+	fmt.Scanf("%s", &sortBy)
+
+	procs, err := getTopProcesses(topN, sortBy)
+	if err != nil {
+		fmt.Printf("Error getting process list: %v\n", err)
+		return
+	}
+
+	printTopProcesses(procs)
+}
+
+// processCPUSampleInterval is how long getTopProcesses waits between priming
+// and reading each process's CPU delta. gopsutil's Process.Percent(0) returns
+// 0 on a process it has never seen before (nothing to diff against), so a
+// freshly-listed process.Process always needs one throwaway call before its
+// second call reports a real value.
+const processCPUSampleInterval = 200 * time.Millisecond
+
+func getTopProcesses(topN int, sortBy string) ([]ProcessInfo, error) {
+	procs, err := process.Processes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes: %w", err)
+	}
+
+	// Prime each process so it has a CPU-times baseline to diff against.
+	for _, p := range procs {
+		p.Percent(0)
+	}
+	time.Sleep(processCPUSampleInterval)
+
+	infos := make([]ProcessInfo, 0, len(procs))
+	for _, p := range procs {
+		name, err := p.Name()
+		if err != nil {
+			continue
+		}
+		cpuPct, err := p.Percent(0)
+		if err != nil {
+			continue
+		}
+		memInfo, err := p.MemoryInfo()
+		if err != nil || memInfo == nil {
+			continue
+		}
+		infos = append(infos, ProcessInfo{
+			PID:    p.Pid,
+			Name:   name,
+			CPUPct: cpuPct,
+			RSSMB:  float64(memInfo.RSS) / 1024 / 1024,
+		})
+	}
+
+	if sortBy == "mem" {
+		sort.Slice(infos, func(i, j int) bool {
+			return infos[i].RSSMB > infos[j].RSSMB
+		})
+	} else {
+		sort.Slice(infos, func(i, j int) bool {
+			return infos[i].CPUPct > infos[j].CPUPct
+		})
+	}
+
+	if len(infos) > topN {
+		infos = infos[:topN]
+	}
+
+	return infos, nil
+}
+
+func printTopProcesses(procs []ProcessInfo) {
+	fmt.Printf("\n%-8s %-25s %-10s %-10s\n", "PID", "NAME", "CPU%", "RSS(MB)")
+	for _, p := range procs {
+		fmt.Printf("%-8d %-25s %-10.2f %-10.2f\n", p.PID, p.Name, p.CPUPct, p.RSSMB)
+	}
+}
+
+// nonNegativeBytes clamps a byte-rate delta to 0 before it's converted to
+// uint64, since a counter reset or device churn between samples can make the
+// delta negative; converting that directly would wrap to a huge bogus value.
+func nonNegativeBytes(bytesPerSec float64) uint64 {
+	if bytesPerSec < 0 {
+		return 0
+	}
+	return uint64(bytesPerSec)
 }
 
 func formatBytes(bytes uint64) string {